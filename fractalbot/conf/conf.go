@@ -0,0 +1,165 @@
+// Package conf loads and hot-reloads FractalBot's JSON configuration file.
+//
+// The layout mirrors b3log/wide's conf package: a single package-level
+// Config is loaded at startup with Load, mutated in place on disk changes,
+// and read elsewhere in the process through Get. Callers that need to react
+// to a reload (the gateway re-binding, the channel manager reconnecting,
+// and so on) subscribe to event.ConfigReloaded on event.Default.
+package conf
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/elliot245/oh-my-code/fractalbot/event"
+	"github.com/elliot245/oh-my-code/fractalbot/paths"
+)
+
+// Config is the root of fractalbot.conf.
+type Config struct {
+	// GatewayAddr is the listen address of the gRPC/HTTP gateway, e.g. "{IP}:7070".
+	GatewayAddr string `json:"gateway_addr"`
+
+	ChannelManager ChannelManagerConfig     `json:"channel_manager"`
+	Runtime        map[string]RuntimeConfig `json:"runtime"`
+	Users          []UserConfig             `json:"users"`
+}
+
+// ChannelManagerConfig configures the channel manager component.
+type ChannelManagerConfig struct {
+	Driver     string `json:"driver"`   // e.g. "memory", "redis", "nats"
+	Endpoint   string `json:"endpoint"` // driver-specific connection string
+	BufferSize int    `json:"buffer_size"`
+}
+
+// RuntimeConfig is a single agent's runtime block, keyed by agent name in
+// Config.Runtime.
+type RuntimeConfig struct {
+	Image          string            `json:"image"`
+	WorkDir        string            `json:"workdir"` // supports {PWD}, {PID}, {IP} templates
+	MaxConcurrency int               `json:"max_concurrency"`
+	Env            map[string]string `json:"env"`
+}
+
+// UserConfig describes one FractalBot user and the workspace/permissions
+// they operate under.
+type UserConfig struct {
+	Name        string   `json:"name"`
+	Workspace   string   `json:"workspace"` // supports {PWD}, {PID}, {IP} templates
+	Permissions []string `json:"permissions"`
+}
+
+var (
+	mu   sync.RWMutex
+	cfg  *Config
+	path string
+)
+
+// Load reads and parses the configuration file at path, resolves template
+// fields ({IP}, {PWD}, {PID}), creates each user's workspace directory if it
+// doesn't yet exist, and installs the result as the process-wide Config
+// returned by Get. It is safe to call Load again later (e.g. from the
+// watcher) to pick up an edited file.
+func Load(p string) (*Config, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("conf: read %s: %w", p, err)
+	}
+
+	c := &Config{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("conf: parse %s: %w", p, err)
+	}
+
+	if err := substituteTemplates(c); err != nil {
+		return nil, fmt.Errorf("conf: resolve templates in %s: %w", p, err)
+	}
+
+	if err := ensureUserWorkspaces(c.Users); err != nil {
+		return nil, fmt.Errorf("conf: prepare user workspaces: %w", err)
+	}
+
+	mu.Lock()
+	cfg = c
+	path = p
+	mu.Unlock()
+
+	event.Publish(event.Event{Kind: event.ConfigReloaded, Data: p})
+
+	return c, nil
+}
+
+// LoadDefault resolves name (typically "fractalbot.conf") against
+// paths.Resolve's XDG-style search path and loads the first match. It's
+// what callers that weren't given an explicit config path should use.
+func LoadDefault(name string) (*Config, error) {
+	p, err := paths.Resolve()
+	if err != nil {
+		return nil, fmt.Errorf("conf: %w", err)
+	}
+	file, err := p.FindConfig(name)
+	if err != nil {
+		return nil, fmt.Errorf("conf: %w", err)
+	}
+	return Load(file)
+}
+
+// Get returns the currently loaded Config. It returns nil if Load has not
+// been called yet.
+func Get() *Config {
+	mu.RLock()
+	defer mu.RUnlock()
+	return cfg
+}
+
+// Save writes the currently loaded Config back to the path it was loaded
+// from, pretty-printed.
+func Save() error {
+	mu.RLock()
+	c, p := cfg, path
+	mu.RUnlock()
+
+	if c == nil || p == "" {
+		return fmt.Errorf("conf: Save called before Load")
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("conf: marshal config: %w", err)
+	}
+
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("conf: write %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		return fmt.Errorf("conf: rename %s to %s: %w", tmp, p, err)
+	}
+	return nil
+}
+
+func ensureUserWorkspaces(users []UserConfig) error {
+	for _, u := range users {
+		if u.Workspace == "" {
+			continue
+		}
+		if err := os.MkdirAll(u.Workspace, 0o755); err != nil {
+			return fmt.Errorf("workspace for user %q: %w", u.Name, err)
+		}
+	}
+	return nil
+}
+
+// Dir returns the directory the active configuration file lives in, or ""
+// if nothing has been loaded yet.
+func Dir() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if path == "" {
+		return ""
+	}
+	return filepath.Dir(path)
+}
@@ -0,0 +1,92 @@
+package conf
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// substituteTemplates walks every string field of c and replaces the
+// {IP}, {PWD} and {PID} placeholders with their resolved values. It is run
+// once per Load so that e.g. a Runtime.WorkDir of "{PWD}/agents/{PID}"
+// becomes an absolute, process-specific path before anything tries to use
+// it.
+func substituteTemplates(c *Config) error {
+	pwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("resolve {PWD}: %w", err)
+	}
+	ip, err := localIP()
+	if err != nil {
+		return fmt.Errorf("resolve {IP}: %w", err)
+	}
+	pid := strconv.Itoa(os.Getpid())
+
+	replacer := strings.NewReplacer(
+		"{IP}", ip,
+		"{PWD}", pwd,
+		"{PID}", pid,
+	)
+
+	substituteStrings(reflect.ValueOf(c), replacer)
+	return nil
+}
+
+// substituteStrings recursively applies replacer to every settable string
+// field reachable from v (structs, maps, slices and pointers).
+func substituteStrings(v reflect.Value, replacer *strings.Replacer) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			substituteStrings(v.Elem(), replacer)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			substituteStrings(v.Field(i), replacer)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			substituteStrings(v.Index(i), replacer)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			mv := v.MapIndex(k)
+			if mv.Kind() == reflect.String {
+				v.SetMapIndex(k, reflect.ValueOf(replacer.Replace(mv.String())))
+				continue
+			}
+			// Maps of structs aren't addressable via MapIndex, so copy,
+			// mutate and store back.
+			cp := reflect.New(mv.Type()).Elem()
+			cp.Set(mv)
+			substituteStrings(cp, replacer)
+			v.SetMapIndex(k, cp)
+		}
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(replacer.Replace(v.String()))
+		}
+	}
+}
+
+// localIP returns the first non-loopback IPv4 address found on the host,
+// falling back to "127.0.0.1" if none is found.
+func localIP() (string, error) {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return "", err
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.IsLoopback() {
+			continue
+		}
+		if ip4 := ipNet.IP.To4(); ip4 != nil {
+			return ip4.String(), nil
+		}
+	}
+	return "127.0.0.1", nil
+}
@@ -0,0 +1,106 @@
+package conf
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSubstituteStringsNestedShapes(t *testing.T) {
+	type inner struct {
+		Dir string
+	}
+	type withNesting struct {
+		Name   string
+		Nested *inner
+		ByUser map[string]inner
+		Tags   []string
+	}
+
+	v := &withNesting{
+		Name:   "static",
+		Nested: &inner{Dir: "{PWD}/nested"},
+		ByUser: map[string]inner{"alice": {Dir: "{PWD}/alice/{PID}"}},
+		Tags:   []string{"{IP}", "static-tag"},
+	}
+
+	replacer := strings.NewReplacer("{IP}", "203.0.113.5", "{PWD}", "/work", "{PID}", "42")
+	substituteStrings(reflect.ValueOf(v), replacer)
+
+	if v.Nested.Dir != "/work/nested" {
+		t.Errorf("Nested.Dir = %q, want %q", v.Nested.Dir, "/work/nested")
+	}
+	if got := v.ByUser["alice"].Dir; got != "/work/alice/42" {
+		t.Errorf("ByUser[alice].Dir = %q, want %q", got, "/work/alice/42")
+	}
+	if v.Tags[0] != "203.0.113.5" {
+		t.Errorf("Tags[0] = %q, want %q", v.Tags[0], "203.0.113.5")
+	}
+	if v.Name != "static" {
+		t.Errorf("Name was mutated to %q, want unchanged %q", v.Name, "static")
+	}
+}
+
+func TestSubstituteStringsNilPointerIsNoop(t *testing.T) {
+	type inner struct{ Dir string }
+	type withNilPtr struct {
+		Nested *inner
+	}
+
+	v := &withNilPtr{}
+	replacer := strings.NewReplacer("{PWD}", "/work")
+
+	// Must not panic on a nil nested pointer.
+	substituteStrings(reflect.ValueOf(v), replacer)
+
+	if v.Nested != nil {
+		t.Errorf("Nested = %v, want nil", v.Nested)
+	}
+}
+
+func TestLoadResolvesTemplatesAndCreatesWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	workspace := filepath.Join(dir, "workspace-{PID}")
+
+	cfgFile := filepath.Join(dir, "fractalbot.conf")
+	raw, err := json.Marshal(map[string]any{
+		"gateway_addr": "{IP}:7070",
+		"runtime": map[string]any{
+			"default": map[string]any{"workdir": "{PWD}/agents"},
+		},
+		"users": []any{
+			map[string]any{"name": "admin", "workspace": workspace},
+		},
+	})
+	if err != nil {
+		t.Fatalf("marshal fixture: %v", err)
+	}
+	if err := os.WriteFile(cfgFile, raw, 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+
+	cfg, err := Load(cfgFile)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	pwd, _ := os.Getwd()
+	pid := strconv.Itoa(os.Getpid())
+
+	wantWorkDir := pwd + "/agents"
+	if got := cfg.Runtime["default"].WorkDir; got != wantWorkDir {
+		t.Errorf("Runtime[default].WorkDir = %q, want %q", got, wantWorkDir)
+	}
+
+	wantWorkspace := filepath.Join(dir, "workspace-"+pid)
+	if got := cfg.Users[0].Workspace; got != wantWorkspace {
+		t.Errorf("Users[0].Workspace = %q, want %q", got, wantWorkspace)
+	}
+	if _, err := os.Stat(wantWorkspace); err != nil {
+		t.Errorf("expected workspace directory to be created: %v", err)
+	}
+}
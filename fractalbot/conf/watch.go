@@ -0,0 +1,60 @@
+package conf
+
+import (
+	"log"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch starts watching the file most recently passed to Load for changes
+// and reloads it in the background whenever it is written, publishing
+// event.ConfigReloaded on event.Default each time. It returns a stop
+// function that tears down the watcher; the returned error is only
+// non-nil if the watcher itself could not be created.
+func Watch() (stop func(), err error) {
+	mu.RLock()
+	p := path
+	mu.RUnlock()
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Add(Dir()); err != nil {
+		w.Close()
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case ev, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				if ev.Name != p {
+					continue
+				}
+				if ev.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+				if _, err := Load(p); err != nil {
+					log.Printf("conf: reload %s failed: %v", p, err)
+				}
+			case err, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+				log.Printf("conf: watcher error: %v", err)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		w.Close()
+	}, nil
+}
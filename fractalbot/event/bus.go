@@ -0,0 +1,96 @@
+// Package event is a small typed publish/subscribe bus used to decouple
+// FractalBot's components from one another, in the spirit of b3log/wide's
+// event package: the gateway, channel manager and agent runtime publish
+// lifecycle events here instead of calling into each other directly, and
+// anything that cares (loggers, metrics, the CLI banner) subscribes.
+package event
+
+import "log"
+
+// Kind identifies the lifecycle moment an Event represents.
+type Kind string
+
+const (
+	GatewayStarted   Kind = "gateway_started"
+	AgentRegistered  Kind = "agent_registered"
+	ChannelConnected Kind = "channel_connected"
+	ConfigReloaded   Kind = "config_reloaded"
+	Shutdown         Kind = "shutdown"
+)
+
+// Event is published to every subscriber of its Kind. Data carries
+// kind-specific detail (e.g. the agent ID for AgentRegistered); subscribers
+// should type-assert it, or ignore it if they only care that the event
+// happened.
+type Event struct {
+	Kind Kind
+	Data any
+}
+
+// maxPending bounds how many events a slow subscriber may queue before the
+// bus starts dropping new ones for it rather than blocking the publisher.
+const maxPending = 32
+
+// Bus fans Events of a given Kind out to every subscriber of that Kind.
+// The zero value is not usable; construct one with NewBus.
+type Bus struct {
+	subs chan subRequest
+	pub  chan Event
+}
+
+type subRequest struct {
+	kind Kind
+	ch   chan Event
+}
+
+// NewBus starts a Bus and returns it. The bus runs its dispatch loop in a
+// background goroutine for the lifetime of the process.
+func NewBus() *Bus {
+	b := &Bus{
+		subs: make(chan subRequest),
+		pub:  make(chan Event),
+	}
+	go b.run()
+	return b
+}
+
+func (b *Bus) run() {
+	subsByKind := make(map[Kind][]chan Event)
+	for {
+		select {
+		case req := <-b.subs:
+			subsByKind[req.kind] = append(subsByKind[req.kind], req.ch)
+		case e := <-b.pub:
+			for _, ch := range subsByKind[e.Kind] {
+				select {
+				case ch <- e:
+				default:
+					log.Printf("event: subscriber for %s has %d events pending, dropping", e.Kind, maxPending)
+				}
+			}
+		}
+	}
+}
+
+// Subscribe returns a channel that receives every Event published with the
+// given Kind from this point on.
+func (b *Bus) Subscribe(kind Kind) <-chan Event {
+	ch := make(chan Event, maxPending)
+	b.subs <- subRequest{kind: kind, ch: ch}
+	return ch
+}
+
+// Publish fans e out to every current subscriber of e.Kind.
+func (b *Bus) Publish(e Event) {
+	b.pub <- e
+}
+
+// Default is the process-wide bus used by components that don't need an
+// isolated bus of their own (tests construct their own with NewBus).
+var Default = NewBus()
+
+// Subscribe subscribes to Default.
+func Subscribe(kind Kind) <-chan Event { return Default.Subscribe(kind) }
+
+// Publish publishes to Default.
+func Publish(e Event) { Default.Publish(e) }
@@ -0,0 +1,73 @@
+package event
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBusSubscribePublish(t *testing.T) {
+	b := NewBus()
+	ch := b.Subscribe(GatewayStarted)
+
+	b.Publish(Event{Kind: GatewayStarted, Data: "127.0.0.1:7070"})
+
+	select {
+	case e := <-ch:
+		if e.Data != "127.0.0.1:7070" {
+			t.Errorf("Data = %v, want %q", e.Data, "127.0.0.1:7070")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for published event")
+	}
+}
+
+func TestBusOnlyDeliversToMatchingKind(t *testing.T) {
+	b := NewBus()
+	started := b.Subscribe(GatewayStarted)
+	shutdown := b.Subscribe(Shutdown)
+
+	b.Publish(Event{Kind: GatewayStarted})
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for GatewayStarted subscriber")
+	}
+
+	select {
+	case e := <-shutdown:
+		t.Fatalf("Shutdown subscriber unexpectedly received %v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBusDropsWhenSubscriberFull(t *testing.T) {
+	b := NewBus()
+	ch := b.Subscribe(ConfigReloaded)
+
+	// Fill the subscriber's buffer, then publish well past its capacity
+	// without draining it. None of this should block the publisher.
+	total := maxPending + 10
+	for i := 0; i < total; i++ {
+		b.Publish(Event{Kind: ConfigReloaded, Data: fmt.Sprintf("reload-%d", i)})
+	}
+
+	received := 0
+drain:
+	for {
+		select {
+		case e := <-ch:
+			if e.Data != fmt.Sprintf("reload-%d", received) {
+				t.Errorf("event %d = %v, want in-order reload-%d", received, e.Data, received)
+			}
+			received++
+		default:
+			break drain
+		}
+	}
+
+	if received != maxPending {
+		t.Errorf("received %d events, want exactly the buffer capacity %d (rest should be dropped)", received, maxPending)
+	}
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var agentCmd = &cobra.Command{
+	Use:   "agent",
+	Short: "Manage agent workers",
+}
+
+var agentRunCmd = &cobra.Command{
+	Use:   "run <name>",
+	Short: "Start a single agent worker by name",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runAgentRun,
+}
+
+func init() {
+	agentCmd.AddCommand(agentRunCmd)
+}
+
+func runAgentRun(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	fmt.Printf("📡 Agent runtime: worker %q would start here (not yet implemented)\n", name)
+	return nil
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var channelCmd = &cobra.Command{
+	Use:   "channel",
+	Short: "Inspect and drive the channel manager",
+}
+
+var channelListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configured channels",
+	Args:  cobra.NoArgs,
+	RunE:  runChannelList,
+}
+
+var channelSendCmd = &cobra.Command{
+	Use:   "send <channel> <message>",
+	Short: "Send a message to a channel",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runChannelSend,
+}
+
+func init() {
+	channelCmd.AddCommand(channelListCmd)
+	channelCmd.AddCommand(channelSendCmd)
+}
+
+func runChannelList(cmd *cobra.Command, args []string) error {
+	fmt.Println("📡 Channel manager: no channels to list (not yet implemented)")
+	return nil
+}
+
+func runChannelSend(cmd *cobra.Command, args []string) error {
+	channel, message := args[0], args[1]
+	fmt.Printf("📡 Channel manager: would send %q to channel %q (not yet implemented)\n", message, channel)
+	return nil
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/elliot245/oh-my-code/fractalbot/conf"
+)
+
+// loadConfig loads fractalbot.conf. If --config or FRACTALBOT_CONFIG name
+// an explicit path, that path is used as-is; otherwise the file is found
+// via paths.Resolve's XDG-style search (see conf.LoadDefault).
+func loadConfig() (*conf.Config, error) {
+	if configPath != defaultConfigPath {
+		return conf.Load(configPath)
+	}
+	if p := os.Getenv("FRACTALBOT_CONFIG"); p != "" {
+		return conf.Load(p)
+	}
+	return conf.LoadDefault(defaultConfigPath)
+}
+
+// applyWorkDir chdirs into --workdir when the flag was set. It runs as
+// rootCmd's PersistentPreRunE, so every subcommand honors --workdir before
+// its own Run starts, not just serve.
+func applyWorkDir() error {
+	if workDir == "" {
+		return nil
+	}
+	return os.Chdir(workDir)
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Work with fractalbot.conf",
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Load and validate the configuration file",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigValidate,
+}
+
+func init() {
+	configCmd.AddCommand(configValidateCmd)
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ configuration is valid: gateway=%s channel_driver=%s agents=%d users=%d\n",
+		cfg.GatewayAddr, cfg.ChannelManager.Driver, len(cfg.Runtime), len(cfg.Users))
+	return nil
+}
@@ -0,0 +1,49 @@
+// Package cmd implements FractalBot's command-line interface: a root
+// command with global flags, and one subcommand per component (serve,
+// agent, channel, config, version).
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Version is FractalBot's release version, reported by the version command
+// and embedded in the serve banner.
+const Version = "0.1.0-alpha"
+
+// Global flags shared by every subcommand via rootCmd's persistent flags.
+var (
+	configPath string
+	logLevel   string
+	workDir    string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "fractalbot",
+	Short: "FractalBot - Multi-Agent Orchestration System",
+	Long: "FractalBot coordinates a gateway, a channel manager and a pool of\n" +
+		"agent runtimes. Run `fractalbot serve` to start all three, or use\n" +
+		"the agent/channel/config subcommands to work with a running instance.",
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return applyWorkDir()
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", defaultConfigPath, "path to fractalbot.conf")
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "log level: debug, info, warn, error")
+	rootCmd.PersistentFlags().StringVar(&workDir, "workdir", "", "working directory (defaults to the current directory)")
+
+	rootCmd.AddCommand(serveCmd)
+	rootCmd.AddCommand(agentCmd)
+	rootCmd.AddCommand(channelCmd)
+	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(versionCmd)
+}
+
+// Execute runs the root command, dispatching to whichever subcommand the
+// user invoked. It is the sole entry point called from main.
+func Execute() error {
+	return rootCmd.Execute()
+}
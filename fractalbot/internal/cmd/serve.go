@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/elliot245/oh-my-code/fractalbot/conf"
+	"github.com/elliot245/oh-my-code/fractalbot/event"
+	"github.com/elliot245/oh-my-code/fractalbot/internal/server"
+	"github.com/elliot245/oh-my-code/fractalbot/logging"
+	"github.com/elliot245/oh-my-code/fractalbot/paths"
+)
+
+// defaultConfigPath is used when --config is not set and FRACTALBOT_CONFIG
+// is not exported.
+const defaultConfigPath = "fractalbot.conf"
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Start the gateway server, channel manager and agent runtime",
+	RunE:  runServe,
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	logger := logging.Init(logging.Config{Level: logLevel})
+	stopSubscriber := logging.InstallEventSubscriber(event.Default, logger)
+	defer stopSubscriber()
+
+	fmt.Println("FractalBot - Multi-Agent Orchestration System")
+	fmt.Println("Version: " + Version)
+	fmt.Println()
+
+	p, err := paths.Resolve()
+	if err != nil {
+		return fmt.Errorf("resolve paths: %w", err)
+	}
+	logger.Info("environment resolved", "exec_dir", p.ExecDir, "work_dir", p.WorkDir, "data_dir", p.DataDir)
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("load configuration: %w", err)
+	}
+
+	if _, err := conf.Watch(); err != nil {
+		logger.Warn("configuration file watcher not started", "error", err)
+	}
+
+	lis, err := server.Listen(cfg.GatewayAddr)
+	if err != nil {
+		return err
+	}
+	grpcServer := server.New()
+	event.Publish(event.Event{Kind: event.GatewayStarted, Data: cfg.GatewayAddr})
+
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		<-sigCh
+		event.Publish(event.Event{Kind: event.Shutdown, Data: nil})
+		grpcServer.GracefulStop()
+	}()
+
+	return server.Serve(lis, grpcServer)
+}
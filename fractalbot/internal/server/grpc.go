@@ -0,0 +1,43 @@
+// Package server hosts the gRPC server that backs AgentService,
+// ChannelService and GatewayService (see proto/).
+package server
+
+import (
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/elliot245/oh-my-code/fractalbot/pkg/pb"
+)
+
+// New builds the gRPC server for the gateway, with stub implementations of
+// AgentService, ChannelService and GatewayService registered so downstream
+// contributors have a stable interface to implement each RPC against (see
+// pkg/pb's Unimplemented*Server types).
+func New() *grpc.Server {
+	s := grpc.NewServer()
+	pb.RegisterAgentServiceServer(s, pb.UnimplementedAgentServiceServer{})
+	pb.RegisterChannelServiceServer(s, pb.UnimplementedChannelServiceServer{})
+	pb.RegisterGatewayServiceServer(s, pb.UnimplementedGatewayServiceServer{})
+	reflection.Register(s)
+	return s
+}
+
+// Listen opens addr for the gateway server. It's split out from Serve so
+// callers can publish event.GatewayStarted (or otherwise react) once the
+// socket is bound but before Serve starts blocking.
+func Listen(addr string) (net.Listener, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen on %s: %w", addr, err)
+	}
+	return lis, nil
+}
+
+// Serve blocks, accepting connections on lis for s until it is stopped or
+// the listener fails.
+func Serve(lis net.Listener, s *grpc.Server) error {
+	return s.Serve(lis)
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/elliot245/oh-my-code/fractalbot/pkg/pb"
+)
+
+func dialNew(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := New()
+	go func() {
+		if err := s.Serve(lis); err != nil && err != grpc.ErrServerStopped {
+			t.Errorf("serve: %v", err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+// TestNewRegistersServices checks that New() wires up AgentService,
+// ChannelService and GatewayService (stub handlers included) on a real
+// grpc.Server, not just reflection: calling each service's first RPC
+// should fail with codes.Unimplemented rather than "unknown service".
+func TestNewRegistersServices(t *testing.T) {
+	conn := dialNew(t)
+
+	t.Run("AgentService", func(t *testing.T) {
+		_, err := pb.NewAgentServiceClient(conn).Register(context.Background(), &pb.RegisterRequest{Name: "worker-1"})
+		assertUnimplemented(t, err)
+	})
+	t.Run("ChannelService", func(t *testing.T) {
+		_, err := pb.NewChannelServiceClient(conn).Publish(context.Background(), &pb.PublishRequest{Channel: "events"})
+		assertUnimplemented(t, err)
+	})
+	t.Run("GatewayService", func(t *testing.T) {
+		_, err := pb.NewGatewayServiceClient(conn).SubmitJob(context.Background(), &pb.SubmitJobRequest{JobId: "job-1"})
+		assertUnimplemented(t, err)
+	})
+}
+
+func assertUnimplemented(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected an Unimplemented error from the stub handler, got nil")
+	}
+	if code := status.Code(err); code != codes.Unimplemented {
+		t.Fatalf("expected codes.Unimplemented, got %v", code)
+	}
+}
+
+// TestNewReflectionListsServices checks that reflection.Register keeps
+// working alongside the registered services, so grpcurl and other
+// off-the-shelf reflection clients can discover them.
+func TestNewReflectionListsServices(t *testing.T) {
+	conn := dialNew(t)
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("ServerReflectionInfo: %v", err)
+	}
+	t.Cleanup(func() { stream.CloseSend() })
+
+	req := &reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}
+	if err := stream.Send(req); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		t.Fatalf("recv: %v", err)
+	}
+	if resp.GetErrorResponse() != nil {
+		t.Fatalf("reflection returned an error response: %v", resp.GetErrorResponse())
+	}
+	if len(resp.GetListServicesResponse().GetService()) == 0 {
+		t.Fatal("expected ListServices to report at least one registered service")
+	}
+}
@@ -0,0 +1,63 @@
+// Package logging configures FractalBot's structured logger on top of
+// log/slog and installs a subscriber that turns event.Bus lifecycle events
+// into log lines.
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Config controls how Init builds the process-wide logger.
+type Config struct {
+	// Level is one of "debug", "info", "warn", "error". Defaults to "info".
+	Level string
+	// Format is "json" or "text". Defaults to "text".
+	Format string
+	// File, if set, sends log output to a rotating file instead of stdout.
+	File string
+}
+
+// Init builds a logger from cfg, installs it as slog's default and returns
+// it for callers that want to hold on to a reference explicitly.
+func Init(cfg Config) *slog.Logger {
+	var w io.Writer = os.Stdout
+	if cfg.File != "" {
+		w = &lumberjack.Logger{
+			Filename:   cfg.File,
+			MaxSize:    100, // megabytes
+			MaxBackups: 5,
+			MaxAge:     28, // days
+			Compress:   true,
+		}
+	}
+
+	opts := &slog.HandlerOptions{Level: parseLevel(cfg.Level)}
+	var handler slog.Handler
+	if strings.EqualFold(cfg.Format, "json") {
+		handler = slog.NewJSONHandler(w, opts)
+	} else {
+		handler = slog.NewTextHandler(w, opts)
+	}
+
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+	return logger
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
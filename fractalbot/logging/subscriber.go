@@ -0,0 +1,57 @@
+package logging
+
+import (
+	"log/slog"
+
+	"github.com/elliot245/oh-my-code/fractalbot/event"
+)
+
+// lifecycleKinds is every event.Kind the CLI banner cares about, in the
+// order they're expected to occur across a normal boot.
+var lifecycleKinds = []event.Kind{
+	event.ConfigReloaded,
+	event.GatewayStarted,
+	event.ChannelConnected,
+	event.AgentRegistered,
+	event.Shutdown,
+}
+
+// InstallEventSubscriber subscribes to bus for every lifecycle event kind
+// and pretty-prints each one through logger as it arrives. It replaces the
+// old hard-coded "Not yet implemented" banner lines with real state driven
+// by the components as they actually start up. The returned stop function
+// tears the subscription down.
+func InstallEventSubscriber(bus *event.Bus, logger *slog.Logger) (stop func()) {
+	done := make(chan struct{})
+	for _, kind := range lifecycleKinds {
+		ch := bus.Subscribe(kind)
+		go func(kind event.Kind, ch <-chan event.Event) {
+			for {
+				select {
+				case e := <-ch:
+					logger.Info(prettyMessage(e.Kind), "kind", e.Kind, "data", e.Data)
+				case <-done:
+					return
+				}
+			}
+		}(kind, ch)
+	}
+	return func() { close(done) }
+}
+
+func prettyMessage(kind event.Kind) string {
+	switch kind {
+	case event.GatewayStarted:
+		return "📡 Gateway server: started"
+	case event.AgentRegistered:
+		return "📡 Agent runtime: agent registered"
+	case event.ChannelConnected:
+		return "📡 Channel manager: channel connected"
+	case event.ConfigReloaded:
+		return "⚙️  Configuration (re)loaded"
+	case event.Shutdown:
+		return "🛑 Shutting down"
+	default:
+		return string(kind)
+	}
+}
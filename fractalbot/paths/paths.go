@@ -0,0 +1,114 @@
+// Package paths resolves FractalBot's filesystem layout so it behaves the
+// same whether it's run from a checkout, installed to /usr/local/bin (via a
+// symlink), or launched by systemd with an unrelated working directory —
+// the executable-directory trick popularized by kardianos/osext.
+package paths
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Paths is the resolved filesystem layout for this process.
+type Paths struct {
+	// ExecDir is the directory containing the fractalbot binary, with any
+	// symlinks (e.g. /usr/local/bin/fractalbot) resolved to their target.
+	ExecDir string
+	// WorkDir is the process's current working directory.
+	WorkDir string
+	// ConfigDirs is the ordered list of directories to search for
+	// fractalbot.conf in, most specific first. See FindConfig.
+	ConfigDirs []string
+	// DataDir is the per-user directory for FractalBot's state (workspaces,
+	// logs, etc. that aren't themselves configuration).
+	DataDir string
+}
+
+// Resolve inspects the environment and returns the process's Paths.
+func Resolve() (*Paths, error) {
+	execDir, err := executableDir()
+	if err != nil {
+		return nil, fmt.Errorf("paths: resolve executable dir: %w", err)
+	}
+
+	workDir, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("paths: resolve working dir: %w", err)
+	}
+
+	return &Paths{
+		ExecDir:    execDir,
+		WorkDir:    workDir,
+		ConfigDirs: configSearchDirs(workDir, execDir),
+		DataDir:    dataDir(),
+	}, nil
+}
+
+// FindConfig returns the first "<dir>/name" among p.ConfigDirs that exists
+// on disk.
+func (p *Paths) FindConfig(name string) (string, error) {
+	for _, dir := range p.ConfigDirs {
+		candidate := filepath.Join(dir, name)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("paths: %s not found in %v", name, p.ConfigDirs)
+}
+
+// executableDir returns the directory containing the running binary, with
+// symlinks resolved so an install like /usr/local/bin/fractalbot ->
+// /opt/fractalbot/bin/fractalbot resolves to /opt/fractalbot/bin.
+func executableDir() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	real, err := filepath.EvalSymlinks(exe)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(real), nil
+}
+
+// configSearchDirs returns the ordered list of directories to look for
+// fractalbot.conf in: the current working directory (so `fractalbot serve`
+// still picks up a ./fractalbot.conf the way it always has), then
+// $FRACTALBOT_HOME (a PATH-style list of override directories, most
+// specific first), then $XDG_CONFIG_HOME/fractalbot, then ~/.fractalbot,
+// then the executable's own directory.
+func configSearchDirs(workDir, execDir string) []string {
+	dirs := []string{workDir}
+
+	if home := os.Getenv("FRACTALBOT_HOME"); home != "" {
+		dirs = append(dirs, filepath.SplitList(home)...)
+	}
+
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		for _, d := range filepath.SplitList(xdg) {
+			dirs = append(dirs, filepath.Join(d, "fractalbot"))
+		}
+	}
+
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(homeDir, ".fractalbot"))
+	}
+
+	dirs = append(dirs, execDir)
+	return dirs
+}
+
+// dataDir returns the per-user directory FractalBot should keep its
+// runtime state in, following the same $XDG_DATA_HOME convention as
+// configSearchDirs.
+func dataDir() string {
+	if xdg := os.Getenv("XDG_DATA_HOME"); xdg != "" {
+		dirs := filepath.SplitList(xdg)
+		return filepath.Join(dirs[0], "fractalbot")
+	}
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(homeDir, ".local", "share", "fractalbot")
+	}
+	return filepath.Join(".", "fractalbot-data")
+}
@@ -0,0 +1,65 @@
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestConfigSearchDirsOrdering(t *testing.T) {
+	sep := string(os.PathListSeparator)
+	t.Setenv("FRACTALBOT_HOME", "/override/a"+sep+"/override/b")
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	t.Setenv("HOME", "/home/user")
+
+	got := configSearchDirs("/work", "/exec")
+	want := []string{
+		"/work",
+		"/override/a",
+		"/override/b",
+		"/xdg/fractalbot",
+		"/home/user/.fractalbot",
+		"/exec",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("configSearchDirs() = %v, want %v", got, want)
+	}
+}
+
+func TestConfigSearchDirsWithoutOverrides(t *testing.T) {
+	t.Setenv("FRACTALBOT_HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("HOME", "/home/user")
+
+	got := configSearchDirs("/work", "/exec")
+	want := []string{"/work", "/home/user/.fractalbot", "/exec"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("configSearchDirs() = %v, want %v", got, want)
+	}
+}
+
+func TestFindConfigPrefersEarlierDirs(t *testing.T) {
+	first := t.TempDir()
+	second := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(second, "fractalbot.conf"), []byte("{}"), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+
+	p := &Paths{ConfigDirs: []string{first, second}}
+	got, err := p.FindConfig("fractalbot.conf")
+	if err != nil {
+		t.Fatalf("FindConfig: %v", err)
+	}
+	if want := filepath.Join(second, "fractalbot.conf"); got != want {
+		t.Errorf("FindConfig() = %q, want %q", got, want)
+	}
+}
+
+func TestFindConfigNotFound(t *testing.T) {
+	p := &Paths{ConfigDirs: []string{t.TempDir(), t.TempDir()}}
+	if _, err := p.FindConfig("fractalbot.conf"); err == nil {
+		t.Error("expected an error when no directory has the file, got nil")
+	}
+}
@@ -0,0 +1,8 @@
+// Package pb holds the generated Go bindings for the service contracts
+// defined under proto/ (AgentService, ChannelService, GatewayService):
+// agent.pb.go, channel.pb.go and gateway.pb.go hold the message types,
+// and the matching *_grpc.pb.go files hold the client/server interfaces
+// and Unimplemented*Server stubs. They're vendored rather than gitignored
+// so the module builds without a protoc toolchain on PATH; re-run `make
+// proto` and commit the result when proto/*.proto changes.
+package pb